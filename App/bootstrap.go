@@ -0,0 +1,31 @@
+package App
+
+import (
+	"log"
+
+	entities "tln-backend/Entities"
+	"tln-backend/Interfaces"
+)
+
+// BootstrapAdmin ensures the operator-configured bootstrap admin account
+// carries the admin role, promoting it if necessary. It is safe to call
+// on every startup; it is a no-op once the account is already an admin.
+func BootstrapAdmin(userRepo Interfaces.IUserRepository, bootstrapAdminEmail string) {
+	if bootstrapAdminEmail == "" {
+		return
+	}
+
+	vendor, err := userRepo.GetUserByEmail(bootstrapAdminEmail)
+	if err != nil {
+		log.Printf("bootstrap admin %s not found yet, skipping role promotion", bootstrapAdminEmail)
+		return
+	}
+
+	if vendor.Role == entities.RoleAdmin {
+		return
+	}
+
+	if err := userRepo.UpdateRole(vendor.ID, entities.RoleAdmin); err != nil {
+		log.Printf("failed to promote bootstrap admin %s: %v", bootstrapAdminEmail, err)
+	}
+}
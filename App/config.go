@@ -0,0 +1,21 @@
+package App
+
+import "os"
+
+// Config holds application configuration loaded from the environment.
+type Config struct {
+	App struct {
+		Host                string
+		Port                string
+		BootstrapAdminEmail string
+	}
+}
+
+// LoadConfig reads configuration from environment variables.
+func LoadConfig() (*Config, error) {
+	var config Config
+	config.App.Host = os.Getenv("APP_HOST")
+	config.App.Port = os.Getenv("APP_PORT")
+	config.App.BootstrapAdminEmail = os.Getenv("APP_BOOTSTRAP_ADMIN_EMAIL")
+	return &config, nil
+}
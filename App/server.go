@@ -0,0 +1,39 @@
+package App
+
+import (
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"tln-backend/Interfaces"
+)
+
+// purgeInterval is how often the soft-deleted user purge job runs.
+const purgeInterval = 1 * time.Hour
+
+// purgeAfter is how long a soft-deleted user is kept before it is hard
+// purged, cascading through its bookings and provider ownership.
+const purgeAfter = 30 * 24 * time.Hour
+
+// StartServer starts the Fiber server on address and, alongside it, a
+// background goroutine that hard-deletes users soft-deleted more than
+// purgeAfter ago.
+func StartServer(server *fiber.App, address string, userRepo Interfaces.IUserRepository) {
+	go runPurgeJob(userRepo)
+
+	if err := server.Listen(address); err != nil {
+		log.Fatalf("failed to start server: %v", err)
+	}
+}
+
+func runPurgeJob(userRepo Interfaces.IUserRepository) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		cutoff := time.Now().Add(-purgeAfter)
+		if err := userRepo.PurgeDeletedBefore(cutoff); err != nil {
+			log.Printf("failed to purge soft-deleted users: %v", err)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package dtos
+
+// ErrorResponse is the standard error payload returned by use cases.
+type ErrorResponse struct {
+	Code    int
+	Message string
+}
@@ -0,0 +1,23 @@
+package dtos
+
+import entities "tln-backend/Entities"
+
+// MarketRequest is the payload accepted when creating a market.
+type MarketRequest struct {
+	ProviderID  string  `json:"provider_id"`
+	Name        string  `json:"name"`
+	Address     string  `json:"address"`
+	Description string  `json:"description"`
+	Image       string  `json:"image"`
+	OpenTime    string  `json:"open_time"`
+	CloseTime   string  `json:"close_time"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+}
+
+// NearbyMarketResponse decorates a Market with its distance from the
+// query point, in kilometers.
+type NearbyMarketResponse struct {
+	entities.Market
+	DistanceKm float64 `json:"distance_km"`
+}
@@ -0,0 +1,12 @@
+package dtos
+
+// TenantRequest is the payload accepted when creating a tenant.
+type TenantRequest struct {
+	Name string `json:"name"`
+}
+
+// AttachProviderRequest is the payload accepted when moving a provider
+// into or out of a tenant.
+type AttachProviderRequest struct {
+	ProviderID string `json:"provider_id"`
+}
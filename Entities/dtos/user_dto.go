@@ -0,0 +1,35 @@
+package dtos
+
+// RegisterRequest is the payload accepted when registering a new user.
+type RegisterRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Email    string `json:"email"`
+}
+
+// UpdateUserRequest is the payload accepted when patching a user.
+// Pointer fields distinguish "not provided" from the zero value so an
+// update only touches the attributes the client actually sent.
+type UpdateUserRequest struct {
+	Username  *string `json:"username,omitempty"`
+	Email     *string `json:"email,omitempty"`
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	Password  *string `json:"password,omitempty"`
+}
+
+// UpdateRoleRequest is the payload accepted when an admin changes a
+// user's role.
+type UpdateRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// GetUserResponse is the payload returned for a user lookup.
+type GetUserResponse struct {
+	ID        string   `json:"id"`
+	Username  string   `json:"username"`
+	Email     string   `json:"email"`
+	Bookings  []string `json:"bookings"`
+	FirstName string   `json:"first_name"`
+	LastName  string   `json:"last_name"`
+}
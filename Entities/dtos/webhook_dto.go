@@ -0,0 +1,17 @@
+package dtos
+
+// WebhookRequest is the payload accepted when registering a webhook
+// subscription.
+type WebhookRequest struct {
+	ProviderID string   `json:"provider_id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+}
+
+// WebhookUpdateRequest allows partial updates of a webhook subscription;
+// unset fields are left unchanged.
+type WebhookUpdateRequest struct {
+	URL        *string  `json:"url,omitempty"`
+	EventTypes []string `json:"event_types,omitempty"`
+	Active     *bool    `json:"active,omitempty"`
+}
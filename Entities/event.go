@@ -0,0 +1,10 @@
+package entities
+
+// Event is a single domain occurrence published for asynchronous fan-out
+// to webhook subscribers (e.g. "market.created", "user.deleted").
+type Event struct {
+	Type       string
+	TenantID   string
+	ProviderID string
+	Payload    map[string]interface{}
+}
@@ -0,0 +1,18 @@
+package entities
+
+// Market represents a physical or virtual market owned by a Provider.
+// A Market always belongs to exactly one Tenant, inherited from its
+// owning Provider at creation time.
+type Market struct {
+	ID          string
+	TenantID    string
+	ProviderID  string
+	Name        string
+	Address     string
+	Description string
+	Image       string
+	OpenTime    string
+	CloseTime   string
+	Latitude    float64
+	Longitude   float64
+}
@@ -0,0 +1,9 @@
+package entities
+
+// Provider represents a market operator that owns one or more Markets.
+// A Provider belongs to exactly one Tenant at a time.
+type Provider struct {
+	ID       string
+	TenantID string
+	Name     string
+}
@@ -0,0 +1,10 @@
+package entities
+
+// Vendor roles. RoleAdmin can manage every user of its tenant and move providers
+// between tenants; RoleProvider can create markets; RoleUser is the
+// default for newly registered accounts.
+const (
+	RoleUser     = "user"
+	RoleProvider = "provider"
+	RoleAdmin    = "admin"
+)
@@ -0,0 +1,10 @@
+package entities
+
+// Tenant is the top-level owner of providers and markets, and therefore of
+// every resource that hangs off them (bookings, stalls). Every
+// cross-resource query is scoped to a Tenant so two operators never see
+// each other's data.
+type Tenant struct {
+	ID   string
+	Name string
+}
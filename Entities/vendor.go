@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+// Vendor represents a registered user account. UpdatedAt and Version
+// back optimistic concurrency on updates: a write only succeeds if it
+// targets the Version it last read.
+type Vendor struct {
+	ID        string
+	TenantID  string
+	Username  string
+	Password  string
+	Email     string
+	Role      string
+	FirstName string
+	LastName  string
+	Bookings  []string
+	UpdatedAt time.Time
+	Version   int
+	DeletedAt *time.Time
+}
@@ -0,0 +1,14 @@
+package entities
+
+// Webhook is an external subscription to domain events (market, booking,
+// and user lifecycle events) emitted for a tenant's provider.
+type Webhook struct {
+	ID           string
+	TenantID     string
+	ProviderID   string
+	URL          string
+	Secret       string
+	EventTypes   []string
+	Active       bool
+	FailureCount int
+}
@@ -0,0 +1,25 @@
+package entities
+
+import "time"
+
+// Webhook delivery statuses recorded on every attempt, for debugging and
+// manual redelivery.
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// WebhookDelivery records a single attempt to deliver an Event to a
+// Webhook's URL.
+type WebhookDelivery struct {
+	ID           string
+	WebhookID    string
+	EventType    string
+	Payload      []byte
+	Attempt      int
+	Status       string
+	ResponseCode int
+	ResponseBody string
+	CreatedAt    time.Time
+}
@@ -0,0 +1,142 @@
+package Handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"tln-backend/Entities/dtos"
+	"tln-backend/Usecase"
+)
+
+// AdminHandler exposes user administration endpoints. Every route on
+// this handler is restricted to the admin role by Middleware.RequireRole.
+type AdminHandler struct {
+	useCase *Usecase.UserUseCase
+}
+
+// NewAdminHandler creates a new AdminHandler instance.
+func NewAdminHandler(uc *Usecase.UserUseCase) *AdminHandler {
+	return &AdminHandler{useCase: uc}
+}
+
+// ListUsers godoc
+// @Summary List users
+// @Description List every user belonging to the caller's tenant
+// @Tags admin
+// @Produce  json
+// @Success 200 {array} dtos.GetUserResponse
+// @Failure 500 {object} string "Failed to list users"
+// @Router /admin/users [get]
+// @Security BearerAuth
+func (h *AdminHandler) ListUsers(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+
+	users, err := h.useCase.ListUsers(tenantID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to list users",
+		})
+	}
+
+	return c.JSON(users)
+}
+
+// CreateUser godoc
+// @Summary Create a user
+// @Description Register a new user on behalf of an admin
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param user body dtos.RegisterRequest true "User to create"
+// @Success 201 {object} string "User created successfully"
+// @Failure 500 {object} string "Failed to create user"
+// @Router /admin/users [post]
+// @Security BearerAuth
+func (h *AdminHandler) CreateUser(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+
+	var req dtos.RegisterRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := h.useCase.CreateUser(tenantID, &req); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to create user",
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "User created successfully",
+	})
+}
+
+// DeleteUser godoc
+// @Summary Delete a user
+// @Description Delete any user in the caller's tenant, bypassing the same-user restriction
+// @Tags admin
+// @Param id path string true "User ID"
+// @Success 200 {object} string "User deleted successfully"
+// @Failure 500 {object} string "Failed to delete user"
+// @Router /admin/users/{id} [delete]
+// @Security BearerAuth
+func (h *AdminHandler) DeleteUser(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+	id := c.Params("id")
+
+	if err := h.useCase.DeleteUser(tenantID, id); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to delete user",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "User deleted successfully",
+		"user_id": id,
+	})
+}
+
+// UpdateRole godoc
+// @Summary Update a user's role
+// @Description Change a user's role between user, provider and admin. The target user must belong to the caller's tenant.
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param id path string true "User ID"
+// @Param role body dtos.UpdateRoleRequest true "New role"
+// @Success 200 {object} string "Role updated successfully"
+// @Failure 403 {object} string "User does not belong to the caller's tenant"
+// @Failure 500 {object} string "Failed to update role"
+// @Router /admin/users/{id}/role [patch]
+// @Security BearerAuth
+func (h *AdminHandler) UpdateRole(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+	id := c.Params("id")
+
+	var req dtos.UpdateRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := h.useCase.UpdateRole(tenantID, id, req.Role); err != nil {
+		if errors.Is(err, Usecase.ErrCrossTenantAccess) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "User does not belong to the caller's tenant",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to update role",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Role updated successfully",
+		"user_id": id,
+		"role":    req.Role,
+	})
+}
@@ -0,0 +1,110 @@
+package Handlers
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"tln-backend/Entities/dtos"
+	"tln-backend/Usecase"
+)
+
+// MarketHandler handles market discovery requests.
+type MarketHandler struct {
+	useCase *Usecase.MarketUseCase
+}
+
+// NewMarketHandler creates a new MarketHandler instance.
+func NewMarketHandler(uc *Usecase.MarketUseCase) *MarketHandler {
+	return &MarketHandler{useCase: uc}
+}
+
+// CreateMarket godoc
+// @Summary Create a market
+// @Description Create a market under one of the caller's own providers. Restricted to the provider and admin roles by Middleware.RequireRole.
+// @Tags markets
+// @Accept  json
+// @Produce  json
+// @Param market body dtos.MarketRequest true "Market to create"
+// @Success 201 {object} entities.Market
+// @Failure 403 {object} string "Provider does not belong to the caller's tenant"
+// @Failure 404 {object} string "Provider not found"
+// @Failure 500 {object} string "Failed to create market"
+// @Router /markets [post]
+// @Security BearerAuth
+func (h *MarketHandler) CreateMarket(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+
+	var req dtos.MarketRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	market, errRes := h.useCase.CreateMarket(tenantID, &req)
+	if errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(market)
+}
+
+// NearbyMarkets godoc
+// @Summary Find nearby markets
+// @Description List markets near a coordinate, ordered by distance. Restricted to the caller's own tenant.
+// @Tags markets
+// @Produce  json
+// @Param lat query number true "Latitude"
+// @Param lon query number true "Longitude"
+// @Param radius query number false "Search radius in kilometers" default(5)
+// @Param open_now query bool false "Only return markets open right now"
+// @Param limit query int false "Maximum number of results" default(20)
+// @Success 200 {array} dtos.NearbyMarketResponse
+// @Failure 400 {object} string "Invalid query parameters"
+// @Failure 500 {object} string "Failed to search markets"
+// @Router /markets/nearby [get]
+// @Security BearerAuth
+func (h *MarketHandler) NearbyMarkets(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+
+	lat, err := strconv.ParseFloat(c.Query("lat"), 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid or missing lat",
+		})
+	}
+
+	lon, err := strconv.ParseFloat(c.Query("lon"), 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid or missing lon",
+		})
+	}
+
+	radiusKm, err := strconv.ParseFloat(c.Query("radius", "5"), 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid radius",
+		})
+	}
+
+	openNow := c.QueryBool("open_now", false)
+
+	limit, err := strconv.Atoi(c.Query("limit", "20"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid limit",
+		})
+	}
+
+	markets, errRes := h.useCase.FindNearbyMarkets(tenantID, lat, lon, radiusKm, openNow, limit)
+	if errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.JSON(markets)
+}
@@ -0,0 +1,118 @@
+package Handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"tln-backend/Entities/dtos"
+	"tln-backend/Usecase"
+)
+
+// TenantHandler handles tenant administration requests. Every route on
+// this handler is admin-only; it is the only place a provider can be
+// moved between tenants without recreating its markets.
+type TenantHandler struct {
+	useCase *Usecase.TenantUseCase
+}
+
+// NewTenantHandler creates a new TenantHandler instance.
+func NewTenantHandler(uc *Usecase.TenantUseCase) *TenantHandler {
+	return &TenantHandler{useCase: uc}
+}
+
+// CreateTenant godoc
+// @Summary Create a tenant
+// @Description Create a new tenant that can own providers and markets
+// @Tags tenants
+// @Accept  json
+// @Produce  json
+// @Param tenant body dtos.TenantRequest true "Tenant to create"
+// @Success 201 {object} entities.Tenant
+// @Failure 500 {object} string "Failed to create tenant"
+// @Router /tenants [post]
+// @Security BearerAuth
+func (h *TenantHandler) CreateTenant(c *fiber.Ctx) error {
+	var req dtos.TenantRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	tenant, errRes := h.useCase.CreateTenant(&req)
+	if errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(tenant)
+}
+
+// AttachProvider godoc
+// @Summary Attach a provider to a tenant
+// @Description Move a provider under the given tenant without recreating its markets
+// @Tags tenants
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Tenant ID"
+// @Param provider body dtos.AttachProviderRequest true "Provider to attach"
+// @Success 200 {object} string "Provider attached successfully"
+// @Failure 500 {object} string "Failed to attach provider"
+// @Router /tenants/{id}/attach-provider [post]
+// @Security BearerAuth
+func (h *TenantHandler) AttachProvider(c *fiber.Ctx) error {
+	tenantID := c.Params("id")
+
+	var req dtos.AttachProviderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if errRes := h.useCase.AttachProvider(tenantID, &req); errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message":     "Provider attached successfully",
+		"tenant_id":   tenantID,
+		"provider_id": req.ProviderID,
+	})
+}
+
+// DetachProvider godoc
+// @Summary Detach a provider from a tenant
+// @Description Remove a provider from the given tenant
+// @Tags tenants
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Tenant ID"
+// @Param provider body dtos.AttachProviderRequest true "Provider to detach"
+// @Success 200 {object} string "Provider detached successfully"
+// @Failure 500 {object} string "Failed to detach provider"
+// @Router /tenants/{id}/detach-provider [post]
+// @Security BearerAuth
+func (h *TenantHandler) DetachProvider(c *fiber.Ctx) error {
+	tenantID := c.Params("id")
+
+	var req dtos.AttachProviderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if errRes := h.useCase.DetachProvider(tenantID, &req); errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message":     "Provider detached successfully",
+		"tenant_id":   tenantID,
+		"provider_id": req.ProviderID,
+	})
+}
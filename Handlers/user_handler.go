@@ -1,9 +1,14 @@
 package Handlers
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/gofiber/fiber/v2"
+	entities "tln-backend/Entities"
 	"tln-backend/Entities/dtos"
 	"tln-backend/Usecase"
+	"tln-backend/policy"
 )
 
 // UserHandler handles user-related requests.
@@ -18,31 +23,74 @@ func NewUserHandler(uc *Usecase.UserUseCase) *UserHandler {
 
 // UpdateUser godoc
 // @Summary Update a user
-// @Description Update a user with the provided ID
+// @Description Partially update a user with the provided ID. The caller must send an If-Match header carrying the user's current version.
 // @Tags users
 // @Accept  json
 // @Produce  json
 // @Param id path string true "User ID"
+// @Param If-Match header string true "Expected version"
+// @Param user body dtos.UpdateUserRequest true "Fields to update"
 // @Success 200 {object} string "User updated successfully"
+// @Failure 400 {object} string "Invalid request"
+// @Failure 403 {object} string "You are not authorized to update this user"
+// @Failure 409 {object} string "User was updated by someone else"
 // @Failure 500 {object} string "Failed to update user"
 // @Router /users/{id} [patch]
-//func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
-//
-//	userIdToUpdate := c.Params("id")
-//
-//	err := h.useCase.UpdateUser(userIdToUpdate)
-//	if err != nil {
-//		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-//			"error":   "Failed to update user",
-//			"message": err.Error(),
-//		})
-//	}
-//
-//	return c.JSON(fiber.Map{
-//		"message": "User updated successfully",
-//		"user_id": userIdToUpdate,
-//	})
-//}
+func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
+
+	userIdFromToken := c.Locals("userID").(string)
+	tenantID := c.Locals("tenantID").(string)
+
+	userIdToUpdate := c.Params("id")
+
+	if userIdFromToken != userIdToUpdate {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "You are not authorized to update this user",
+		})
+	}
+
+	expectedVersion, err := strconv.Atoi(c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Missing or invalid If-Match header",
+		})
+	}
+
+	var req dtos.UpdateUserRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	_, err = h.useCase.UpdateUser(tenantID, userIdToUpdate, expectedVersion, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, Usecase.ErrCrossTenantAccess):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "You are not authorized to update this user",
+			})
+		case errors.Is(err, Usecase.ErrVersionConflict):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		case errors.Is(err, Usecase.ErrEmailTaken), errors.Is(err, Usecase.ErrUsernameTaken):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Failed to update user",
+				"message": err.Error(),
+			})
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "User updated successfully",
+		"user_id": userIdToUpdate,
+	})
+}
 
 // DeleteUser godoc
 // @Summary Delete a user
@@ -57,18 +105,41 @@ func NewUserHandler(uc *Usecase.UserUseCase) *UserHandler {
 // @Router /users/{id} [delete]
 func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 
-	userIdFromToken := c.Locals("userID").(string)
+	actorID := c.Locals("userID").(string)
+	tenantID := c.Locals("tenantID").(string)
+
+	actorRole, ok := c.Locals("role").(string)
+	if !ok {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "Token does not carry a role",
+		})
+	}
 
 	userIdToDelete := c.Params("id")
 
-	if userIdFromToken != userIdToDelete {
+	target, err := h.useCase.GetVendorByID(userIdToDelete)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User not found",
+		})
+	}
+
+	actor := &entities.Vendor{ID: actorID, TenantID: tenantID, Role: actorRole}
+
+	if !policy.CanDeleteUser(actor, target) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"error": "You are not authorized to delete this user",
 		})
 	}
 
-	err := h.useCase.DeleteUser(userIdToDelete)
+	err = h.useCase.DeleteUser(tenantID, userIdToDelete)
 	if err != nil {
+		if errors.Is(err, Usecase.ErrCrossTenantAccess) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "You are not authorized to delete this user",
+			})
+		}
+
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to delete user",
 		})
@@ -80,6 +151,66 @@ func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 	})
 }
 
+// RestoreUser godoc
+// @Summary Restore a soft-deleted user
+// @Description Clear the deleted_at marker on a user with the provided ID. The caller must be the same user or an admin, same as DeleteUser.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param id path string true "User ID"
+// @Success 200 {object} string "User restored successfully"
+// @Failure 403 {object} string "You are not authorized to restore this user"
+// @Failure 404 {object} string "User not found"
+// @Failure 500 {object} string "Failed to restore user"
+// @Router /users/{id}/restore [post]
+func (h *UserHandler) RestoreUser(c *fiber.Ctx) error {
+
+	actorID := c.Locals("userID").(string)
+	tenantID := c.Locals("tenantID").(string)
+
+	actorRole, ok := c.Locals("role").(string)
+	if !ok {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "Token does not carry a role",
+		})
+	}
+
+	userIdToRestore := c.Params("id")
+
+	target, err := h.useCase.GetVendorByID(userIdToRestore)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User not found",
+		})
+	}
+
+	actor := &entities.Vendor{ID: actorID, TenantID: tenantID, Role: actorRole}
+
+	if !policy.CanDeleteUser(actor, target) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "You are not authorized to restore this user",
+		})
+	}
+
+	err = h.useCase.RestoreUser(tenantID, userIdToRestore)
+	if err != nil {
+		if errors.Is(err, Usecase.ErrCrossTenantAccess) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "You are not authorized to restore this user",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to restore user",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "User restored successfully",
+		"user_id": userIdToRestore,
+	})
+}
+
 // GetUserByID godoc
 // @Summary Get a user by ID
 // @Description Get a user with the provided ID
@@ -98,6 +229,15 @@ func (h *UserHandler) GetUserByID(c *fiber.Ctx) error {
 	// Fetch user by ID
 	user, err := h.useCase.GetUserByID(userId)
 	if err != nil {
+		// Return a 410 status so clients can distinguish never-existed from deleted
+		if errors.Is(err, Usecase.ErrUserDeleted) {
+			return c.Status(fiber.StatusGone).JSON(fiber.Map{
+				"error":   "User deleted",
+				"message": "This user has been deleted",
+				"data":    dtos.GetUserResponse{},
+			})
+		}
+
 		// Return a 404 status if the user is not found with a detailed error message
 		if err.Error() == "user not found" {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
@@ -0,0 +1,175 @@
+package Handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"tln-backend/Entities/dtos"
+	"tln-backend/Usecase"
+)
+
+// WebhookHandler exposes CRUD for webhook subscriptions plus delivery
+// inspection and redelivery for debugging failed deliveries.
+type WebhookHandler struct {
+	useCase *Usecase.WebhookUseCase
+}
+
+// NewWebhookHandler creates a new WebhookHandler instance.
+func NewWebhookHandler(uc *Usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{useCase: uc}
+}
+
+// RegisterWebhook godoc
+// @Summary Register a webhook
+// @Description Subscribe to market/booking/user lifecycle events
+// @Tags webhooks
+// @Accept  json
+// @Produce  json
+// @Param webhook body dtos.WebhookRequest true "Webhook to register"
+// @Success 201 {object} entities.Webhook
+// @Failure 500 {object} string "Failed to register webhook"
+// @Router /webhooks [post]
+// @Security BearerAuth
+func (h *WebhookHandler) RegisterWebhook(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+
+	var req dtos.WebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	webhook, errRes := h.useCase.Register(tenantID, &req)
+	if errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(webhook)
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook
+// @Description Partially update a webhook subscription
+// @Tags webhooks
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Webhook ID"
+// @Param webhook body dtos.WebhookUpdateRequest true "Fields to update"
+// @Success 200 {object} entities.Webhook
+// @Failure 404 {object} string "Webhook not found"
+// @Router /webhooks/{id} [patch]
+// @Security BearerAuth
+func (h *WebhookHandler) UpdateWebhook(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+	id := c.Params("id")
+
+	var req dtos.WebhookUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	webhook, errRes := h.useCase.Update(tenantID, id, &req)
+	if errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.JSON(webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook
+// @Description Remove a webhook subscription
+// @Tags webhooks
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} string "Webhook deleted successfully"
+// @Router /webhooks/{id} [delete]
+// @Security BearerAuth
+func (h *WebhookHandler) DeleteWebhook(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+	id := c.Params("id")
+
+	if errRes := h.useCase.Delete(tenantID, id); errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Webhook deleted successfully",
+		"id":      id,
+	})
+}
+
+// ListWebhooks godoc
+// @Summary List webhooks
+// @Description List every webhook subscription for the caller's tenant
+// @Tags webhooks
+// @Produce  json
+// @Success 200 {array} entities.Webhook
+// @Router /webhooks [get]
+// @Security BearerAuth
+func (h *WebhookHandler) ListWebhooks(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+
+	webhooks, errRes := h.useCase.List(tenantID)
+	if errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.JSON(webhooks)
+}
+
+// ListDeliveries godoc
+// @Summary List webhook deliveries
+// @Description List every delivery attempt made for a webhook, for debugging
+// @Tags webhooks
+// @Produce  json
+// @Param id path string true "Webhook ID"
+// @Success 200 {array} entities.WebhookDelivery
+// @Router /webhooks/{id}/deliveries [get]
+// @Security BearerAuth
+func (h *WebhookHandler) ListDeliveries(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+	webhookID := c.Params("id")
+
+	deliveries, errRes := h.useCase.ListDeliveries(tenantID, webhookID)
+	if errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.JSON(deliveries)
+}
+
+// RedeliverDelivery godoc
+// @Summary Redeliver a webhook delivery
+// @Description Re-send a previously attempted delivery, for debugging
+// @Tags webhooks
+// @Param id path string true "Webhook ID"
+// @Param deliveryID path string true "Delivery ID"
+// @Success 202 {object} string "Redelivery queued"
+// @Router /webhooks/{id}/deliveries/{deliveryID}/redeliver [post]
+// @Security BearerAuth
+func (h *WebhookHandler) RedeliverDelivery(c *fiber.Ctx) error {
+	tenantID := c.Locals("tenantID").(string)
+	webhookID := c.Params("id")
+	deliveryID := c.Params("deliveryID")
+
+	if errRes := h.useCase.Redeliver(tenantID, webhookID, deliveryID); errRes != nil {
+		return c.Status(errRes.Code).JSON(fiber.Map{
+			"error": errRes.Message,
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message": "Redelivery queued",
+	})
+}
@@ -0,0 +1,20 @@
+package Interfaces
+
+import "errors"
+
+// Sentinel errors repository implementations return so Usecase can tell
+// specific failure conditions apart from generic errors, without
+// Interfaces importing Usecase (that would invert the dependency between
+// the two packages).
+var (
+	// ErrNotFound is returned when a lookup finds no matching row.
+	ErrNotFound = errors.New("not found")
+
+	// ErrVersionConflict is returned by UpdateUser when expectedVersion no
+	// longer matches the stored row's version.
+	ErrVersionConflict = errors.New("version conflict")
+
+	// ErrSoftDeleted is returned by GetUserByID when the row exists but
+	// has been soft-deleted.
+	ErrSoftDeleted = errors.New("soft deleted")
+)
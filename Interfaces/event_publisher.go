@@ -0,0 +1,10 @@
+package Interfaces
+
+import entities "tln-backend/Entities"
+
+// IEventPublisher accepts domain events for asynchronous delivery to any
+// interested subscriber. The webhook subsystem is currently the only
+// implementation.
+type IEventPublisher interface {
+	Publish(event entities.Event)
+}
@@ -0,0 +1,16 @@
+package Interfaces
+
+import (
+	entities "tln-backend/Entities"
+	entitiesDtos "tln-backend/Entities/dtos"
+)
+
+// IMarket defines the persistence operations required by MarketUseCase.
+type IMarket interface {
+	CreateMarket(market *entities.Market) error
+	GetMarketByName(tenantID, name string) (*entities.Market, *entitiesDtos.ErrorResponse)
+	GetMarketWithProviderByID(id string) (*entities.Market, *entitiesDtos.ErrorResponse)
+	GetProviderByID(id string) (*entities.Provider, *entitiesDtos.ErrorResponse)
+	ListMarketsByTenant(tenantID string) ([]*entities.Market, *entitiesDtos.ErrorResponse)
+	FindMarketsNear(tenantID string, lat, lon, radiusKm float64, openNow bool, limit int) ([]*entitiesDtos.NearbyMarketResponse, *entitiesDtos.ErrorResponse)
+}
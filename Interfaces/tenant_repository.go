@@ -0,0 +1,13 @@
+package Interfaces
+
+import (
+	entities "tln-backend/Entities"
+	entitiesDtos "tln-backend/Entities/dtos"
+)
+
+// ITenant defines the persistence operations required by TenantUseCase.
+type ITenant interface {
+	CreateTenant(tenant *entities.Tenant) error
+	AttachProvider(tenantID, providerID string) *entitiesDtos.ErrorResponse
+	DetachProvider(tenantID, providerID string) *entitiesDtos.ErrorResponse
+}
@@ -0,0 +1,34 @@
+package Interfaces
+
+import (
+	"time"
+
+	entities "tln-backend/Entities"
+	entitiesDtos "tln-backend/Entities/dtos"
+)
+
+// IUserRepository defines the persistence operations required by UserUseCase.
+type IUserRepository interface {
+	CreateUser(vendor *entities.Vendor) error
+	// GetUserByID returns ErrSoftDeleted if the user exists but has been
+	// soft-deleted, and ErrNotFound if no such user ever existed.
+	GetUserByID(id string) (*entitiesDtos.GetUserResponse, error)
+	GetVendorByID(id string) (*entities.Vendor, error)
+	// GetUserByEmail returns ErrNotFound if no vendor has this email.
+	GetUserByEmail(email string) (*entities.Vendor, error)
+	// GetUserByUsername returns ErrNotFound if no vendor has this username.
+	GetUserByUsername(username string) (*entities.Vendor, error)
+	// UpdateUser returns ErrVersionConflict if expectedVersion no longer
+	// matches the stored row's version.
+	UpdateUser(vendor *entities.Vendor, expectedVersion int) error
+	// DeleteUser soft-deletes a vendor by setting deleted_at.
+	DeleteUser(id string) error
+	// RestoreUser clears a vendor's deleted_at marker.
+	RestoreUser(id string) error
+	// PurgeDeletedBefore hard-deletes vendors (and cascades through their
+	// bookings and provider ownership) soft-deleted before cutoff.
+	PurgeDeletedBefore(cutoff time.Time) error
+	// ListUsers returns every user belonging to tenantID.
+	ListUsers(tenantID string) ([]*entitiesDtos.GetUserResponse, error)
+	UpdateRole(id, role string) error
+}
@@ -0,0 +1,23 @@
+package Interfaces
+
+import entities "tln-backend/Entities"
+
+// IWebhook defines the persistence operations required by WebhookUseCase.
+type IWebhook interface {
+	CreateWebhook(webhook *entities.Webhook) error
+	UpdateWebhook(webhook *entities.Webhook) error
+	DeleteWebhook(id string) error
+	GetWebhookByID(id string) (*entities.Webhook, error)
+	ListWebhooks(tenantID string) ([]*entities.Webhook, error)
+	ListWebhooksByEventType(tenantID, eventType string) ([]*entities.Webhook, error)
+	IncrementFailureCount(webhookID string) (int, error)
+	// ResetFailureCount clears a webhook's consecutive-failure counter,
+	// called after a successful delivery so transient failures don't
+	// accumulate toward deactivation.
+	ResetFailureCount(webhookID string) error
+	DeactivateWebhook(webhookID string) error
+
+	CreateDelivery(delivery *entities.WebhookDelivery) error
+	GetDelivery(webhookID, deliveryID string) (*entities.WebhookDelivery, error)
+	ListDeliveries(webhookID string) ([]*entities.WebhookDelivery, error)
+}
@@ -0,0 +1,64 @@
+package Middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ResolveRole reads the role claim from the JWT token already validated
+// upstream and stores it on c.Locals("role"), the same way ResolveTenant
+// does for tenant_id, so RequireRole and handlers can rely on it being set.
+func ResolveRole() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token, ok := c.Locals("user").(*jwt.Token)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Missing or invalid token",
+			})
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Invalid token claims",
+			})
+		}
+
+		role, ok := claims["role"].(string)
+		if !ok || role == "" {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "Token does not carry a role",
+			})
+		}
+
+		c.Locals("role", role)
+		return c.Next()
+	}
+}
+
+// RequireRole gates a route to callers whose JWT role claim (already
+// resolved onto c.Locals("role")) is one of roles, rejecting everyone
+// else with 403.
+func RequireRole(roles ...string) fiber.Handler {
+	allowed := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		allowed[role] = struct{}{}
+	}
+
+	return func(c *fiber.Ctx) error {
+		role, ok := c.Locals("role").(string)
+		if !ok {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "Token does not carry a role",
+			})
+		}
+
+		if _, permitted := allowed[role]; !permitted {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "You do not have permission to perform this action",
+			})
+		}
+
+		return c.Next()
+	}
+}
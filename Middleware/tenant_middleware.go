@@ -0,0 +1,37 @@
+package Middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ResolveTenant reads the tenant_id claim from the JWT token already
+// validated upstream and stores it on c.Locals("tenantID") so handlers and
+// use cases can scope every read/write to the caller's own tenant.
+func ResolveTenant() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token, ok := c.Locals("user").(*jwt.Token)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Missing or invalid token",
+			})
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Invalid token claims",
+			})
+		}
+
+		tenantID, ok := claims["tenant_id"].(string)
+		if !ok || tenantID == "" {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "Token does not carry a tenant",
+			})
+		}
+
+		c.Locals("tenantID", tenantID)
+		return c.Next()
+	}
+}
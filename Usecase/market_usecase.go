@@ -8,19 +8,21 @@ import (
 )
 
 type MarketUseCase struct {
-	repo Interfaces.IMarket
+	repo      Interfaces.IMarket
+	publisher Interfaces.IEventPublisher
 }
 
-func NewMarketUseCase(repo Interfaces.IMarket) *MarketUseCase {
+func NewMarketUseCase(repo Interfaces.IMarket, publisher Interfaces.IEventPublisher) *MarketUseCase {
 	return &MarketUseCase{
-		repo: repo,
+		repo:      repo,
+		publisher: publisher,
 	}
 
 }
 
-func (uc *MarketUseCase) CreateMarket(marketReq *entitiesDtos.MarketRequest) (*entities.Market, *entitiesDtos.ErrorResponse) {
+func (uc *MarketUseCase) CreateMarket(tenantID string, marketReq *entitiesDtos.MarketRequest) (*entities.Market, *entitiesDtos.ErrorResponse) {
 	// Check if the provider exists
-	_, errRes := uc.repo.GetProviderByID(marketReq.ProviderID)
+	provider, errRes := uc.repo.GetProviderByID(marketReq.ProviderID)
 	if errRes != nil {
 		return nil, &entitiesDtos.ErrorResponse{
 			Code:    404,
@@ -28,8 +30,16 @@ func (uc *MarketUseCase) CreateMarket(marketReq *entitiesDtos.MarketRequest) (*e
 		}
 	}
 
-	// Check if a market with the same name already exists
-	existingMarket, errRes := uc.repo.GetMarketByName(marketReq.Name)
+	// A provider can only be used to create markets by its own tenant
+	if provider.TenantID != tenantID {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    403,
+			Message: "Provider does not belong to the caller's tenant",
+		}
+	}
+
+	// Check if a market with the same name already exists within this tenant
+	existingMarket, errRes := uc.repo.GetMarketByName(tenantID, marketReq.Name)
 	if errRes != nil && errRes.Code != 404 { // If error is not "not found", return it
 		return nil, &entitiesDtos.ErrorResponse{
 			Code:    500,
@@ -47,6 +57,7 @@ func (uc *MarketUseCase) CreateMarket(marketReq *entitiesDtos.MarketRequest) (*e
 	// Map the MarketRequest to Market entity
 	marketEntity := entities.Market{
 		ID:          uuid.New().String(),
+		TenantID:    tenantID,
 		ProviderID:  marketReq.ProviderID,
 		Name:        marketReq.Name,
 		Address:     marketReq.Address,
@@ -76,6 +87,47 @@ func (uc *MarketUseCase) CreateMarket(marketReq *entitiesDtos.MarketRequest) (*e
 		}
 	}
 
+	// Notify webhook subscribers that a market was created
+	uc.publisher.Publish(entities.Event{
+		Type:       "market.created",
+		TenantID:   createdMarket.TenantID,
+		ProviderID: createdMarket.ProviderID,
+		Payload: map[string]interface{}{
+			"market_id": createdMarket.ID,
+			"name":      createdMarket.Name,
+		},
+	})
+
 	// Return the created market with provider details
 	return createdMarket, nil
 }
+
+// GetMarketWithProviderByID returns a single market scoped to the caller's
+// tenant, rejecting cross-tenant reads with a 403.
+func (uc *MarketUseCase) GetMarketWithProviderByID(tenantID, marketID string) (*entities.Market, *entitiesDtos.ErrorResponse) {
+	market, errRes := uc.repo.GetMarketWithProviderByID(marketID)
+	if errRes != nil {
+		return nil, errRes
+	}
+
+	if market.TenantID != tenantID {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    403,
+			Message: "Market does not belong to the caller's tenant",
+		}
+	}
+
+	return market, nil
+}
+
+// ListMarketsByTenant returns every market owned by the given tenant.
+func (uc *MarketUseCase) ListMarketsByTenant(tenantID string) ([]*entities.Market, *entitiesDtos.ErrorResponse) {
+	return uc.repo.ListMarketsByTenant(tenantID)
+}
+
+// FindNearbyMarkets returns markets within radiusKm of the given
+// coordinates, ordered by distance, optionally filtered to markets that
+// are open right now. Results are scoped to the caller's tenant.
+func (uc *MarketUseCase) FindNearbyMarkets(tenantID string, lat, lon, radiusKm float64, openNow bool, limit int) ([]*entitiesDtos.NearbyMarketResponse, *entitiesDtos.ErrorResponse) {
+	return uc.repo.FindMarketsNear(tenantID, lat, lon, radiusKm, openNow, limit)
+}
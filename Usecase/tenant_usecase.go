@@ -0,0 +1,40 @@
+package Usecase
+
+import (
+	"github.com/google/uuid"
+	entities "tln-backend/Entities"
+	entitiesDtos "tln-backend/Entities/dtos"
+	"tln-backend/Interfaces"
+)
+
+type TenantUseCase struct {
+	repo Interfaces.ITenant
+}
+
+func NewTenantUseCase(repo Interfaces.ITenant) *TenantUseCase {
+	return &TenantUseCase{repo: repo}
+}
+
+func (uc *TenantUseCase) CreateTenant(tenantReq *entitiesDtos.TenantRequest) (*entities.Tenant, *entitiesDtos.ErrorResponse) {
+	tenantEntity := entities.Tenant{
+		ID:   uuid.New().String(),
+		Name: tenantReq.Name,
+	}
+
+	if err := uc.repo.CreateTenant(&tenantEntity); err != nil {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    500,
+			Message: "Failed to create tenant: " + err.Error(),
+		}
+	}
+
+	return &tenantEntity, nil
+}
+
+func (uc *TenantUseCase) AttachProvider(tenantID string, req *entitiesDtos.AttachProviderRequest) *entitiesDtos.ErrorResponse {
+	return uc.repo.AttachProvider(tenantID, req.ProviderID)
+}
+
+func (uc *TenantUseCase) DetachProvider(tenantID string, req *entitiesDtos.AttachProviderRequest) *entitiesDtos.ErrorResponse {
+	return uc.repo.DetachProvider(tenantID, req.ProviderID)
+}
@@ -1,50 +1,227 @@
 package Usecase
 
 import (
+	"errors"
 	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
 	entities "tln-backend/Entities"
 	entitiesDtos "tln-backend/Entities/dtos"
 	"tln-backend/Interfaces"
 )
 
+// ErrCrossTenantAccess is returned when a caller attempts to operate on a
+// user that belongs to a different tenant.
+var ErrCrossTenantAccess = errors.New("user does not belong to the caller's tenant")
+
+// ErrVersionConflict is returned when an UpdateUser call's If-Match
+// version no longer matches the stored row, i.e. someone else updated
+// the user first.
+var ErrVersionConflict = errors.New("user was updated by someone else, please retry")
+
+// ErrEmailTaken is returned when the requested email is already in use
+// by another vendor.
+var ErrEmailTaken = errors.New("email already in use")
+
+// ErrUsernameTaken is returned when the requested username is already in
+// use by another vendor.
+var ErrUsernameTaken = errors.New("username already in use")
+
+// ErrUserDeleted is returned when a soft-deleted user is looked up, so
+// callers can distinguish never-existed from deleted.
+var ErrUserDeleted = errors.New("user has been deleted")
+
+// ErrUserNotFound is returned when a looked-up user never existed.
+var ErrUserNotFound = errors.New("user not found")
+
 type UserUseCase struct {
-	repo Interfaces.IUserRepository
+	repo      Interfaces.IUserRepository
+	publisher Interfaces.IEventPublisher
 }
 
-func NewUserUseCase(repo Interfaces.IUserRepository) *UserUseCase {
-	return &UserUseCase{repo: repo}
+func NewUserUseCase(repo Interfaces.IUserRepository, publisher Interfaces.IEventPublisher) *UserUseCase {
+	return &UserUseCase{repo: repo, publisher: publisher}
 }
 
-func (uc *UserUseCase) CreateUser(registerUser *entitiesDtos.RegisterRequest) error {
+// CreateUser registers a new vendor under tenantID with the default user
+// role, for use by admin-facing creation endpoints.
+func (uc *UserUseCase) CreateUser(tenantID string, registerUser *entitiesDtos.RegisterRequest) error {
 	var newVendor entities.Vendor
 
 	newVendor = entities.Vendor{
+		TenantID: tenantID,
 		Username: registerUser.Username,
 		Password: registerUser.Password,
 		Email:    registerUser.Email,
+		Role:     entities.RoleUser,
 	}
 
 	return uc.repo.CreateUser(&newVendor)
 }
 
 func (uc *UserUseCase) GetUserByID(id string) (*entitiesDtos.GetUserResponse, error) {
-	return uc.repo.GetUserByID(id)
+	user, err := uc.repo.GetUserByID(id)
+	if err != nil {
+		if errors.Is(err, Interfaces.ErrSoftDeleted) {
+			return nil, ErrUserDeleted
+		}
+		if errors.Is(err, Interfaces.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetVendorByID returns the raw vendor record, used by callers (e.g. the
+// handlers that pass it to the policy package) that need fields not
+// present on GetUserResponse, such as Role.
+func (uc *UserUseCase) GetVendorByID(id string) (*entities.Vendor, error) {
+	return uc.repo.GetVendorByID(id)
+}
+
+// ListUsers returns every user belonging to tenantID, for admin use.
+func (uc *UserUseCase) ListUsers(tenantID string) ([]*entitiesDtos.GetUserResponse, error) {
+	return uc.repo.ListUsers(tenantID)
 }
 
-//	func (uc *UserUseCase) UpdateUser(userID string) error {
-//		err := uc.repo.UpdateUser(userID)
-//		if err != nil {
-//			// Log the error or handle it as necessary
-//			return fmt.Errorf("use case error: %w", err)
-//		}
-//		return nil
-//	}
-func (uc *UserUseCase) DeleteUser(id string) error {
+// UpdateRole changes a user's role, for admin use. The target user must
+// belong to the caller's tenant.
+func (uc *UserUseCase) UpdateRole(tenantID, id, role string) error {
+	vendor, err := uc.repo.GetVendorByID(id)
+	if err != nil {
+		return fmt.Errorf("use case error: %w", err)
+	}
+
+	if vendor.TenantID != tenantID {
+		return ErrCrossTenantAccess
+	}
+
+	return uc.repo.UpdateRole(id, role)
+}
 
-	err := uc.repo.DeleteUser(id)
+// UpdateUser applies a partial update to a vendor. Unset fields on req
+// are left untouched. The update is guarded by optimistic concurrency:
+// it only succeeds if expectedVersion still matches the stored row,
+// otherwise ErrVersionConflict is returned so the caller can re-fetch
+// and retry.
+func (uc *UserUseCase) UpdateUser(tenantID, id string, expectedVersion int, req *entitiesDtos.UpdateUserRequest) (*entities.Vendor, error) {
+	vendor, err := uc.repo.GetVendorByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("use case error: %w", err)
+	}
+
+	if vendor.TenantID != tenantID {
+		return nil, ErrCrossTenantAccess
+	}
+
+	if req.Email != nil && *req.Email != vendor.Email {
+		existing, err := uc.repo.GetUserByEmail(*req.Email)
+		if err != nil && !errors.Is(err, Interfaces.ErrNotFound) {
+			return nil, fmt.Errorf("use case error: %w", err)
+		}
+		if existing != nil {
+			return nil, ErrEmailTaken
+		}
+		vendor.Email = *req.Email
+	}
+
+	if req.Username != nil && *req.Username != vendor.Username {
+		existing, err := uc.repo.GetUserByUsername(*req.Username)
+		if err != nil && !errors.Is(err, Interfaces.ErrNotFound) {
+			return nil, fmt.Errorf("use case error: %w", err)
+		}
+		if existing != nil {
+			return nil, ErrUsernameTaken
+		}
+		vendor.Username = *req.Username
+	}
+
+	if req.FirstName != nil {
+		vendor.FirstName = *req.FirstName
+	}
+
+	if req.LastName != nil {
+		vendor.LastName = *req.LastName
+	}
+
+	if req.Password != nil {
+		hashed, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("use case error: %w", err)
+		}
+		vendor.Password = string(hashed)
+	}
+
+	if err := uc.repo.UpdateUser(vendor, expectedVersion); err != nil {
+		if errors.Is(err, Interfaces.ErrVersionConflict) {
+			return nil, ErrVersionConflict
+		}
+		return nil, fmt.Errorf("use case error: %w", err)
+	}
+
+	uc.publisher.Publish(entities.Event{
+		Type:     "user.updated",
+		TenantID: tenantID,
+		Payload: map[string]interface{}{
+			"user_id": id,
+		},
+	})
+
+	return vendor, nil
+}
+
+func (uc *UserUseCase) DeleteUser(tenantID, id string) error {
+
+	vendor, err := uc.repo.GetVendorByID(id)
+	if err != nil {
+		return fmt.Errorf("use case error: %w", err)
+	}
+
+	if vendor.TenantID != tenantID {
+		return ErrCrossTenantAccess
+	}
+
+	err = uc.repo.DeleteUser(id)
 	if err != nil {
 		// Log the error or handle it as necessary
 		return fmt.Errorf("use case error: %w", err)
 	}
+
+	uc.publisher.Publish(entities.Event{
+		Type:     "user.deleted",
+		TenantID: tenantID,
+		Payload: map[string]interface{}{
+			"user_id": id,
+		},
+	})
+
+	return nil
+}
+
+// RestoreUser clears a previously soft-deleted user's deleted_at marker.
+func (uc *UserUseCase) RestoreUser(tenantID, id string) error {
+
+	vendor, err := uc.repo.GetVendorByID(id)
+	if err != nil {
+		return fmt.Errorf("use case error: %w", err)
+	}
+
+	if vendor.TenantID != tenantID {
+		return ErrCrossTenantAccess
+	}
+
+	if err := uc.repo.RestoreUser(id); err != nil {
+		return fmt.Errorf("use case error: %w", err)
+	}
+
+	uc.publisher.Publish(entities.Event{
+		Type:     "user.restored",
+		TenantID: tenantID,
+		Payload: map[string]interface{}{
+			"user_id": id,
+		},
+	})
+
 	return nil
 }
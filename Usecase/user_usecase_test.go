@@ -0,0 +1,69 @@
+package Usecase
+
+import (
+	"errors"
+	"testing"
+
+	entities "tln-backend/Entities"
+	entitiesDtos "tln-backend/Entities/dtos"
+	"tln-backend/Interfaces"
+)
+
+type fakeUserRepo struct {
+	Interfaces.IUserRepository
+	vendor     *entities.Vendor
+	updateErr  error
+	updateVer  int
+	updateCall bool
+}
+
+func (f *fakeUserRepo) GetVendorByID(id string) (*entities.Vendor, error) {
+	return f.vendor, nil
+}
+
+func (f *fakeUserRepo) GetUserByEmail(email string) (*entities.Vendor, error) {
+	return nil, Interfaces.ErrNotFound
+}
+
+func (f *fakeUserRepo) GetUserByUsername(username string) (*entities.Vendor, error) {
+	return nil, Interfaces.ErrNotFound
+}
+
+func (f *fakeUserRepo) UpdateUser(vendor *entities.Vendor, expectedVersion int) error {
+	f.updateCall = true
+	f.updateVer = expectedVersion
+	return f.updateErr
+}
+
+type fakePublisher struct{}
+
+func (fakePublisher) Publish(event entities.Event) {}
+
+func TestUpdateUserVersionConflict(t *testing.T) {
+	repo := &fakeUserRepo{
+		vendor:    &entities.Vendor{ID: "u1", TenantID: "t1", Version: 2},
+		updateErr: Interfaces.ErrVersionConflict,
+	}
+	uc := NewUserUseCase(repo, fakePublisher{})
+
+	firstName := "New"
+	_, err := uc.UpdateUser("t1", "u1", 1, &entitiesDtos.UpdateUserRequest{FirstName: &firstName})
+
+	if !errors.Is(err, ErrVersionConflict) {
+		t.Fatalf("UpdateUser() error = %v, want ErrVersionConflict", err)
+	}
+}
+
+func TestUpdateUserCrossTenantDenied(t *testing.T) {
+	repo := &fakeUserRepo{vendor: &entities.Vendor{ID: "u1", TenantID: "other-tenant"}}
+	uc := NewUserUseCase(repo, fakePublisher{})
+
+	_, err := uc.UpdateUser("t1", "u1", 1, &entitiesDtos.UpdateUserRequest{})
+
+	if !errors.Is(err, ErrCrossTenantAccess) {
+		t.Fatalf("UpdateUser() error = %v, want ErrCrossTenantAccess", err)
+	}
+	if repo.updateCall {
+		t.Error("UpdateUser should not reach the repository on cross-tenant access")
+	}
+}
@@ -0,0 +1,335 @@
+package Usecase
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	entities "tln-backend/Entities"
+	entitiesDtos "tln-backend/Entities/dtos"
+	"tln-backend/Interfaces"
+)
+
+// webhookRetrySchedule is the delay before each redelivery attempt. Once
+// every attempt in the schedule has failed, the webhook's FailureCount is
+// bumped and the subscription is deactivated after maxConsecutiveFailures.
+var webhookRetrySchedule = []time.Duration{
+	1 * time.Second,
+	5 * time.Second,
+	30 * time.Second,
+	5 * time.Minute,
+	30 * time.Minute,
+	24 * time.Hour,
+}
+
+const maxConsecutiveFailures = 10
+
+// webhookJob is a single delivery attempt queued on the worker pool.
+type webhookJob struct {
+	webhook *entities.Webhook
+	event   entities.Event
+	attempt int
+}
+
+// WebhookUseCase manages webhook subscriptions and asynchronously
+// delivers domain events to them, retrying failed deliveries with
+// exponential backoff.
+type WebhookUseCase struct {
+	repo   Interfaces.IWebhook
+	client *http.Client
+	jobs   chan webhookJob
+}
+
+// NewWebhookUseCase creates a WebhookUseCase and starts its delivery
+// worker pool; workers controls how many deliveries run concurrently.
+func NewWebhookUseCase(repo Interfaces.IWebhook, workers int) *WebhookUseCase {
+	uc := &WebhookUseCase{
+		repo:   repo,
+		client: &http.Client{Timeout: 10 * time.Second},
+		jobs:   make(chan webhookJob, 256),
+	}
+
+	for i := 0; i < workers; i++ {
+		go uc.worker()
+	}
+
+	return uc
+}
+
+// Publish implements Interfaces.IEventPublisher. It looks up every active
+// webhook subscribed to the event's type and enqueues a first delivery
+// attempt for each.
+func (uc *WebhookUseCase) Publish(event entities.Event) {
+	webhooks, err := uc.repo.ListWebhooksByEventType(event.TenantID, event.Type)
+	if err != nil {
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Active {
+			continue
+		}
+		uc.jobs <- webhookJob{webhook: webhook, event: event}
+	}
+}
+
+func (uc *WebhookUseCase) worker() {
+	for job := range uc.jobs {
+		uc.deliver(job)
+	}
+}
+
+func (uc *WebhookUseCase) deliver(job webhookJob) {
+	body, err := json.Marshal(job.event.Payload)
+	if err != nil {
+		return
+	}
+
+	delivery := &entities.WebhookDelivery{
+		ID:        uuid.New().String(),
+		WebhookID: job.webhook.ID,
+		EventType: job.event.Type,
+		Payload:   body,
+		Attempt:   job.attempt + 1,
+		CreatedAt: time.Now(),
+	}
+
+	req, err := http.NewRequest(http.MethodPost, job.webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		uc.recordFailure(job, delivery, 0, err.Error())
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Talardnad-Signature", "sha256="+signPayload(job.webhook.Secret, body))
+	req.Header.Set("X-Talardnad-Event", job.event.Type)
+	req.Header.Set("X-Talardnad-Delivery", delivery.ID)
+
+	resp, err := uc.client.Do(req)
+	if err != nil {
+		uc.recordFailure(job, delivery, 0, err.Error())
+		return
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		delivery.Status = entities.WebhookDeliveryStatusSucceeded
+		delivery.ResponseCode = resp.StatusCode
+		delivery.ResponseBody = string(responseBody)
+		_ = uc.repo.CreateDelivery(delivery)
+		_ = uc.repo.ResetFailureCount(job.webhook.ID)
+		return
+	}
+
+	uc.recordFailure(job, delivery, resp.StatusCode, string(responseBody))
+}
+
+func (uc *WebhookUseCase) recordFailure(job webhookJob, delivery *entities.WebhookDelivery, responseCode int, responseBody string) {
+	delivery.Status = entities.WebhookDeliveryStatusFailed
+	delivery.ResponseCode = responseCode
+	delivery.ResponseBody = responseBody
+	_ = uc.repo.CreateDelivery(delivery)
+
+	if job.attempt < len(webhookRetrySchedule) {
+		delay := webhookRetrySchedule[job.attempt]
+		nextJob := webhookJob{webhook: job.webhook, event: job.event, attempt: job.attempt + 1}
+		time.AfterFunc(delay, func() {
+			uc.jobs <- nextJob
+		})
+		return
+	}
+
+	failures, err := uc.repo.IncrementFailureCount(job.webhook.ID)
+	if err == nil && failures >= maxConsecutiveFailures {
+		_ = uc.repo.DeactivateWebhook(job.webhook.ID)
+	}
+}
+
+func signPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Register creates a new webhook subscription for the caller's tenant,
+// generating a signing secret for it.
+func (uc *WebhookUseCase) Register(tenantID string, req *entitiesDtos.WebhookRequest) (*entities.Webhook, *entitiesDtos.ErrorResponse) {
+	webhook := entities.Webhook{
+		ID:         uuid.New().String(),
+		TenantID:   tenantID,
+		ProviderID: req.ProviderID,
+		URL:        req.URL,
+		Secret:     uuid.New().String(),
+		EventTypes: req.EventTypes,
+		Active:     true,
+	}
+
+	if err := uc.repo.CreateWebhook(&webhook); err != nil {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    500,
+			Message: "Failed to register webhook: " + err.Error(),
+		}
+	}
+
+	return &webhook, nil
+}
+
+// Update applies a partial update to an existing webhook subscription
+// owned by the caller's tenant.
+func (uc *WebhookUseCase) Update(tenantID, id string, req *entitiesDtos.WebhookUpdateRequest) (*entities.Webhook, *entitiesDtos.ErrorResponse) {
+	webhook, err := uc.repo.GetWebhookByID(id)
+	if err != nil {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    404,
+			Message: "Webhook not found",
+		}
+	}
+
+	if webhook.TenantID != tenantID {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    403,
+			Message: "Webhook does not belong to the caller's tenant",
+		}
+	}
+
+	if req.URL != nil {
+		webhook.URL = *req.URL
+	}
+	if req.EventTypes != nil {
+		webhook.EventTypes = req.EventTypes
+	}
+	if req.Active != nil {
+		webhook.Active = *req.Active
+	}
+
+	if err := uc.repo.UpdateWebhook(webhook); err != nil {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    500,
+			Message: "Failed to update webhook: " + err.Error(),
+		}
+	}
+
+	return webhook, nil
+}
+
+// Delete removes a webhook subscription owned by the caller's tenant.
+func (uc *WebhookUseCase) Delete(tenantID, id string) *entitiesDtos.ErrorResponse {
+	webhook, err := uc.repo.GetWebhookByID(id)
+	if err != nil {
+		return &entitiesDtos.ErrorResponse{
+			Code:    404,
+			Message: "Webhook not found",
+		}
+	}
+
+	if webhook.TenantID != tenantID {
+		return &entitiesDtos.ErrorResponse{
+			Code:    403,
+			Message: "Webhook does not belong to the caller's tenant",
+		}
+	}
+
+	if err := uc.repo.DeleteWebhook(id); err != nil {
+		return &entitiesDtos.ErrorResponse{
+			Code:    500,
+			Message: "Failed to delete webhook: " + err.Error(),
+		}
+	}
+	return nil
+}
+
+// List returns every webhook subscription owned by the given tenant.
+func (uc *WebhookUseCase) List(tenantID string) ([]*entities.Webhook, *entitiesDtos.ErrorResponse) {
+	webhooks, err := uc.repo.ListWebhooks(tenantID)
+	if err != nil {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    500,
+			Message: "Failed to list webhooks: " + err.Error(),
+		}
+	}
+	return webhooks, nil
+}
+
+// ListDeliveries returns every delivery attempt recorded for a webhook
+// owned by the caller's tenant.
+func (uc *WebhookUseCase) ListDeliveries(tenantID, webhookID string) ([]*entities.WebhookDelivery, *entitiesDtos.ErrorResponse) {
+	webhook, err := uc.repo.GetWebhookByID(webhookID)
+	if err != nil {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    404,
+			Message: "Webhook not found",
+		}
+	}
+
+	if webhook.TenantID != tenantID {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    403,
+			Message: "Webhook does not belong to the caller's tenant",
+		}
+	}
+
+	deliveries, err := uc.repo.ListDeliveries(webhookID)
+	if err != nil {
+		return nil, &entitiesDtos.ErrorResponse{
+			Code:    500,
+			Message: "Failed to list deliveries: " + err.Error(),
+		}
+	}
+	return deliveries, nil
+}
+
+// Redeliver re-queues a previously attempted delivery from attempt zero,
+// for debugging a webhook that has since been fixed. The webhook must
+// belong to the caller's tenant.
+func (uc *WebhookUseCase) Redeliver(tenantID, webhookID, deliveryID string) *entitiesDtos.ErrorResponse {
+	webhook, err := uc.repo.GetWebhookByID(webhookID)
+	if err != nil {
+		return &entitiesDtos.ErrorResponse{
+			Code:    404,
+			Message: "Webhook not found",
+		}
+	}
+
+	if webhook.TenantID != tenantID {
+		return &entitiesDtos.ErrorResponse{
+			Code:    403,
+			Message: "Webhook does not belong to the caller's tenant",
+		}
+	}
+
+	delivery, err := uc.repo.GetDelivery(webhookID, deliveryID)
+	if err != nil {
+		return &entitiesDtos.ErrorResponse{
+			Code:    404,
+			Message: "Delivery not found",
+		}
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(delivery.Payload, &payload); err != nil {
+		return &entitiesDtos.ErrorResponse{
+			Code:    500,
+			Message: "Failed to decode original payload: " + err.Error(),
+		}
+	}
+
+	uc.jobs <- webhookJob{
+		webhook: webhook,
+		event: entities.Event{
+			Type:       delivery.EventType,
+			TenantID:   webhook.TenantID,
+			ProviderID: webhook.ProviderID,
+			Payload:    payload,
+		},
+	}
+
+	return nil
+}
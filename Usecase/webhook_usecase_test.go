@@ -0,0 +1,73 @@
+package Usecase
+
+import (
+	"testing"
+
+	entities "tln-backend/Entities"
+	"tln-backend/Interfaces"
+)
+
+func TestSignPayload(t *testing.T) {
+	got := signPayload("secret", []byte("payload"))
+	want := "b82fcb791acec57859b989b430a826488ce2e479fdf92326bd0a2e8375a42ba4"
+
+	if got != want {
+		t.Errorf("signPayload() = %s, want %s", got, want)
+	}
+}
+
+// fakeWebhookRepo is a minimal Interfaces.IWebhook stub recording the
+// calls recordFailure makes once the retry schedule is exhausted.
+type fakeWebhookRepo struct {
+	Interfaces.IWebhook
+	incrementCalls int
+	incrementValue int
+	deactivated    string
+}
+
+func (f *fakeWebhookRepo) IncrementFailureCount(webhookID string) (int, error) {
+	f.incrementCalls++
+	return f.incrementValue, nil
+}
+
+func (f *fakeWebhookRepo) DeactivateWebhook(webhookID string) error {
+	f.deactivated = webhookID
+	return nil
+}
+
+func (f *fakeWebhookRepo) CreateDelivery(delivery *entities.WebhookDelivery) error {
+	return nil
+}
+
+func TestRecordFailureDeactivatesAfterMaxConsecutiveFailures(t *testing.T) {
+	repo := &fakeWebhookRepo{incrementValue: maxConsecutiveFailures}
+	uc := &WebhookUseCase{repo: repo}
+
+	webhook := &entities.Webhook{ID: "wh1"}
+	job := webhookJob{webhook: webhook, attempt: len(webhookRetrySchedule)}
+	delivery := &entities.WebhookDelivery{WebhookID: webhook.ID}
+
+	uc.recordFailure(job, delivery, 500, "server error")
+
+	if repo.incrementCalls != 1 {
+		t.Fatalf("IncrementFailureCount calls = %d, want 1", repo.incrementCalls)
+	}
+	if repo.deactivated != webhook.ID {
+		t.Errorf("DeactivateWebhook called with %q, want %q", repo.deactivated, webhook.ID)
+	}
+}
+
+func TestRecordFailureKeepsActiveBelowThreshold(t *testing.T) {
+	repo := &fakeWebhookRepo{incrementValue: maxConsecutiveFailures - 1}
+	uc := &WebhookUseCase{repo: repo}
+
+	webhook := &entities.Webhook{ID: "wh1"}
+	job := webhookJob{webhook: webhook, attempt: len(webhookRetrySchedule)}
+	delivery := &entities.WebhookDelivery{WebhookID: webhook.ID}
+
+	uc.recordFailure(job, delivery, 500, "server error")
+
+	if repo.deactivated != "" {
+		t.Errorf("DeactivateWebhook should not be called below the threshold, got %q", repo.deactivated)
+	}
+}
@@ -38,6 +38,8 @@ func main() {
 	server := App.InitializeServer(userRepo, providerRepo)
 	server.MapHandlers(allHandlers)
 
+	App.BootstrapAdmin(userRepo, config.App.BootstrapAdminEmail)
+
 	address := fmt.Sprintf("%s:%s", config.App.Host, config.App.Port)
-	App.StartServer(server, address)
+	App.StartServer(server, address, userRepo)
 }
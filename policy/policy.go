@@ -0,0 +1,16 @@
+// Package policy centralizes authorization rules that would otherwise be
+// duplicated as ad-hoc checks scattered across handlers.
+package policy
+
+import entities "tln-backend/Entities"
+
+// CanDeleteUser reports whether actor may delete target: either actor is
+// deleting their own account, or actor is an admin in target's tenant.
+// An admin from a different tenant is always denied, even over its own
+// users.
+func CanDeleteUser(actor, target *entities.Vendor) bool {
+	if actor.ID == target.ID {
+		return true
+	}
+	return actor.Role == entities.RoleAdmin && actor.TenantID == target.TenantID
+}
@@ -0,0 +1,34 @@
+package policy
+
+import (
+	"testing"
+
+	entities "tln-backend/Entities"
+)
+
+func TestCanDeleteUser(t *testing.T) {
+	self := &entities.Vendor{ID: "v1", TenantID: "t1", Role: entities.RoleUser}
+	admin := &entities.Vendor{ID: "v2", TenantID: "t1", Role: entities.RoleAdmin}
+	stranger := &entities.Vendor{ID: "v3", TenantID: "t1", Role: entities.RoleUser}
+	otherTenantAdmin := &entities.Vendor{ID: "v4", TenantID: "t2", Role: entities.RoleAdmin}
+
+	tests := []struct {
+		name   string
+		actor  *entities.Vendor
+		target *entities.Vendor
+		want   bool
+	}{
+		{"self-delete", self, self, true},
+		{"admin-deletes-same-tenant-user", admin, self, true},
+		{"stranger-denied", stranger, self, false},
+		{"cross-tenant-admin-denied", otherTenantAdmin, self, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanDeleteUser(tt.actor, tt.target); got != tt.want {
+				t.Errorf("CanDeleteUser(%s, %s) = %v, want %v", tt.actor.ID, tt.target.ID, got, tt.want)
+			}
+		})
+	}
+}